@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"selfbot/internal/config"
 	"selfbot/internal/database"
+	"selfbot/internal/ratelimit"
+	"selfbot/internal/utils"
 
 	"github.com/LightningDev1/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -38,12 +41,25 @@ type SimpleBot struct {
 	// Simple channel cache
 	channelCache sync.Map
 	
+	// 429 tracking shared with commands
+	rateLimits *ratelimit.Tracker
+	
+	// Watchdog state - unix nanoseconds of the last gateway event and the one before it
+	lastEvent    int64
+	prevEvent    int64
+	watchdogOnce sync.Once
+	
 	// Command handler will be set after creation to avoid import cycles
 	commandHandler interface {
 		Handle(s *discordgo.Session, m *discordgo.MessageCreate)
+		HandleReaction(s *discordgo.Session, r *discordgo.MessageReactionAdd)
 	}
 }
 
+// unloggedChannels holds channels whose messages must not be stored, such as a DM
+// running setup. Shared by all instances since they can see the same channel.
+var unloggedChannels sync.Map
+
 // ChannelInfo for caching channel data
 type SimpleChannelInfo struct {
 	Name      string
@@ -58,19 +74,21 @@ func NewSimpleBot(cfg *config.Config, db *database.SimpleDatabase, token string,
 	ctx, cancel := context.WithCancel(context.Background())
 	
 	return &SimpleBot{
-		config:    cfg,
-		database:  db,
-		token:     token,
-		index:     index,
-		ctx:       ctx,
-		cancel:    cancel,
-		startTime: time.Now(),
+		config:     cfg,
+		database:   db,
+		token:      token,
+		index:      index,
+		ctx:        ctx,
+		cancel:     cancel,
+		startTime:  time.Now(),
+		rateLimits: ratelimit.NewTracker(),
 	}
 }
 
 // SetCommandHandler sets the command handler (called after creation to avoid import cycles)
 func (b *SimpleBot) SetCommandHandler(handler interface {
 	Handle(s *discordgo.Session, m *discordgo.MessageCreate)
+	HandleReaction(s *discordgo.Session, r *discordgo.MessageReactionAdd)
 }) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -102,6 +120,7 @@ func (b *SimpleBot) Start(ctx context.Context) error {
 
 	// Add event handlers
 	b.addEventHandlers()
+	b.touchEvent()
 
 	// Open connection with retry logic and selfbot-appropriate delays
 	var openErr error
@@ -148,6 +167,9 @@ func (b *SimpleBot) Start(ctx context.Context) error {
 			b.mu.RUnlock()
 			if ready {
 				log.Infof("Bot instance %d started successfully", b.index)
+				if b.config.Watchdog.Enabled {
+					b.watchdogOnce.Do(func() { go b.runWatchdog() })
+				}
 				return nil
 			}
 		}
@@ -176,6 +198,11 @@ func (b *SimpleBot) Stop() error {
 func (b *SimpleBot) addEventHandlers() {
 	s := b.session
 
+	// Every gateway event - feeds the watchdog
+	s.AddHandler(func(s *discordgo.Session, e *discordgo.Event) {
+		b.touchEvent()
+	})
+
 	// Ready event
 	s.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
 		defer func() {
@@ -246,6 +273,74 @@ func (b *SimpleBot) addEventHandlers() {
 		}
 	})
 
+	// Rate limit events - emitted by the REST client whenever a 429 is received
+	s.AddHandler(func(s *discordgo.Session, r *discordgo.RateLimit) {
+		if r.TooManyRequests == nil {
+			return
+		}
+		route := r.Bucket
+		if route == "" {
+			route = ratelimit.RouteKey(r.URL)
+		}
+		b.rateLimits.RecordRoute(route, r.RetryAfter)
+		log.Warnf("Bot %d rate limited on %s (retry after %v)", b.index, route, r.RetryAfter)
+	})
+
+	// Member events - only recorded for allowlisted guilds as they are high-volume
+	s.AddHandler(func(s *discordgo.Session, m *discordgo.GuildMemberAdd) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("Recovered from panic in member add handler: %v", r)
+			}
+		}()
+		
+		if m.Member == nil || !b.config.IsTrackedGuild(m.GuildID) {
+			return
+		}
+		go b.processMemberEvent("join", m.Member)
+	})
+
+	s.AddHandler(func(s *discordgo.Session, m *discordgo.GuildMemberRemove) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("Recovered from panic in member remove handler: %v", r)
+			}
+		}()
+		
+		if m.Member == nil || !b.config.IsTrackedGuild(m.GuildID) {
+			return
+		}
+		go b.processMemberEvent("leave", m.Member)
+	})
+
+	// Reaction events - used for reaction-driven command controls
+	s.AddHandler(func(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				log.Errorf("Recovered from panic in reaction handler: %v", rec)
+			}
+		}()
+		
+		if r.MessageReaction == nil {
+			return
+		}
+		
+		b.mu.RLock()
+		handler := b.commandHandler
+		b.mu.RUnlock()
+		
+		if handler != nil {
+			go func() {
+				defer func() {
+					if rec := recover(); rec != nil {
+						log.Errorf("Recovered from panic in reaction command handler: %v", rec)
+					}
+				}()
+				handler.HandleReaction(s, r)
+			}()
+		}
+	})
+
 	s.AddHandler(func(s *discordgo.Session, m *discordgo.MessageDelete) {
 		defer func() {
 			if r := recover(); r != nil {
@@ -287,6 +382,79 @@ func (b *SimpleBot) addEventHandlers() {
 	})
 }
 
+// touchEvent records that a gateway event was just received
+func (b *SimpleBot) touchEvent() {
+	atomic.StoreInt64(&b.prevEvent, atomic.SwapInt64(&b.lastEvent, time.Now().UnixNano()))
+}
+
+// runWatchdog reconnects the session if no events arrive within the configured threshold.
+// The heartbeat alone can keep a connection alive that no longer delivers events.
+func (b *SimpleBot) runWatchdog() {
+	threshold := time.Duration(b.config.Watchdog.Threshold) * time.Second
+	interval := threshold / 4
+	if interval < 10*time.Second {
+		interval = 10 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-ticker.C:
+			silence := time.Since(b.GetLastEventTime())
+			if silence < threshold {
+				continue
+			}
+
+			log.Warnf("Bot %d has received no events for %v, reconnecting", b.index, silence.Round(time.Second))
+			b.reconnect()
+		}
+	}
+}
+
+// reconnect closes and reopens the gateway connection
+func (b *SimpleBot) reconnect() {
+	b.mu.Lock()
+	session := b.session
+	if b.ctx.Err() != nil || session == nil {
+		b.mu.Unlock()
+		return
+	}
+	b.isReady = false
+	b.mu.Unlock()
+
+	// Reset the timer so a failed reconnect is retried after a full threshold
+	b.touchEvent()
+
+	if err := session.Close(); err != nil {
+		log.Debugf("Bot %d error closing session for reconnect: %v", b.index, err)
+	}
+
+	// Stop may have run while closing; don't reopen a stopped bot
+	b.mu.Lock()
+	stopped := b.ctx.Err() != nil
+	b.mu.Unlock()
+	if stopped {
+		return
+	}
+
+	if err := session.Open(); err != nil {
+		log.Errorf("Bot %d failed to reconnect: %v", b.index, err)
+		return
+	}
+
+	// If Stop ran during Open its Close came too early, so close the reopened session
+	if b.ctx.Err() != nil {
+		session.Close()
+		return
+	}
+
+	log.Infof("Bot %d reconnected by watchdog", b.index)
+}
+
 // processMessage handles incoming messages with simple, direct approach
 func (b *SimpleBot) processMessage(m *discordgo.Message) {
 	if m.Author.Bot {
@@ -301,6 +469,10 @@ func (b *SimpleBot) processMessage(m *discordgo.Message) {
 		return
 	}
 
+	if _, skip := unloggedChannels.Load(m.ChannelID); skip {
+		return
+	}
+
 	// Create simple message data
 	msgData := &database.SimpleMessageData{
 		ID:         m.ID,
@@ -357,6 +529,7 @@ func (b *SimpleBot) processDeletedMessage(m *discordgo.Message) {
 	}
 
 	msgData := &database.SimpleDeletedMessageData{
+		ID:        m.ID,
 		MessageID: m.ID,
 		UserID:    m.Author.ID,
 		Username:  m.Author.Username,
@@ -382,8 +555,19 @@ func (b *SimpleBot) processDeletedMessage(m *discordgo.Message) {
 		}
 	}
 
-	if err := b.database.StoreDeletedMessage(msgData); err != nil {
+	inserted, err := b.database.StoreDeletedMessage(msgData)
+	if err != nil {
 		log.Errorf("Bot %d failed to store deleted message: %v", b.index, err)
+		return
+	}
+
+	// Mirror after storing so a slow download never delays or drops the message.
+	// Only the instance that stored the record mirrors, so each blob ref is one record.
+	if inserted && b.config.AttachmentMirror.Enabled && len(m.Attachments) > 0 {
+		mirrored := b.mirrorAttachments(m.Attachments)
+		if err := b.database.SetDeletedMessageMirror(m.ID, mirrored); err != nil {
+			log.Errorf("Bot %d failed to record mirrored attachments: %v", b.index, err)
+		}
 	}
 }
 
@@ -443,6 +627,54 @@ func (b *SimpleBot) processEditedMessage(before, after *discordgo.Message) {
 	}
 }
 
+// processMemberEvent stores a guild join or leave, tolerating partial member data
+func (b *SimpleBot) processMemberEvent(event string, member *discordgo.Member) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Recovered from panic in processMemberEvent: %v", r)
+		}
+	}()
+
+	// With state disabled, leave events may carry nothing beyond the user
+	if member.User == nil || member.User.ID == "" {
+		log.Debugf("Bot %d skipping %s event without user data in guild %s", b.index, event, member.GuildID)
+		return
+	}
+
+	b.mu.RLock()
+	userID := b.userID
+	b.mu.RUnlock()
+
+	eventData := &database.SimpleMemberEventData{
+		Event:      event,
+		GuildID:    member.GuildID,
+		UserID:     member.User.ID,
+		Username:   member.User.Username,
+		IsBot:      member.User.Bot,
+		EventAt:    time.Now(),
+		InstanceID: userID,
+	}
+
+	if event == "join" && !member.JoinedAt.IsZero() {
+		eventData.EventAt = member.JoinedAt
+	}
+
+	if createdAt, err := utils.SnowflakeTime(member.User.ID); err == nil {
+		eventData.AccountCreatedAt = createdAt
+		threshold := time.Duration(b.config.MemberTracking.NewAccountDays) * 24 * time.Hour
+		eventData.IsNewAccount = eventData.EventAt.Sub(createdAt) < threshold
+	}
+
+	if eventData.IsNewAccount && event == "join" {
+		log.Warnf("Bot %d: new account %s (%s) joined guild %s, created %s",
+			b.index, eventData.Username, eventData.UserID, eventData.GuildID, eventData.AccountCreatedAt.Format(time.RFC3339))
+	}
+
+	if err := b.database.StoreMemberEvent(eventData); err != nil {
+		log.Errorf("Bot %d failed to store member event: %v", b.index, err)
+	}
+}
+
 // processMention handles mentions
 func (b *SimpleBot) processMention(m *discordgo.Message) {
 	b.mu.RLock()
@@ -687,4 +919,24 @@ func (b *SimpleBot) GetUsername() string {
 
 func (b *SimpleBot) GetDatabase() *database.SimpleDatabase {
 	return b.database
+}
+
+func (b *SimpleBot) GetRateLimits() *ratelimit.Tracker {
+	return b.rateLimits
+}
+
+// PauseMessageLogging stops storing messages from channelID until the returned func is called
+func (b *SimpleBot) PauseMessageLogging(channelID string) func() {
+	unloggedChannels.Store(channelID, struct{}{})
+	return func() { unloggedChannels.Delete(channelID) }
+}
+
+func (b *SimpleBot) GetLastEventTime() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&b.lastEvent))
+}
+
+// GetPreviousEventTime returns when the event before the latest one arrived.
+// A command's own message is the latest event, so this is what it should report.
+func (b *SimpleBot) GetPreviousEventTime() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&b.prevEvent))
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package bot
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,59 +30,101 @@ func NewSimpleManager(cfg *config.Config, db *database.SimpleDatabase) *SimpleMa
 	}
 }
 
-// StartAll starts all bot instances concurrently but simply
+// InstanceError associates a startup failure with its bot instance index
+type InstanceError struct {
+	Index int
+	Err   error
+}
+
+func (e *InstanceError) Error() string {
+	return fmt.Sprintf("instance %d: %v", e.Index, e.Err)
+}
+
+func (e *InstanceError) Unwrap() error {
+	return e.Err
+}
+
+// StartError lists every instance that failed to start, ordered by index
+type StartError struct {
+	Total    int
+	Started  int
+	Failures []*InstanceError
+}
+
+func (e *StartError) Error() string {
+	parts := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		parts[i] = failure.Error()
+	}
+	return fmt.Sprintf("failed to start %d/%d bots: %s", len(e.Failures), e.Total, strings.Join(parts, ", "))
+}
+
+// StartAll starts all bot instances with bounded concurrency, reporting failures by index
 func (m *SimpleManager) StartAll(ctx context.Context) error {
-	log.Infof("Starting %d bot instances...", len(m.config.Tokens))
+	total := len(m.config.Tokens)
+	concurrency := m.config.StartupConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	log.Infof("Starting %d bot instances (%d at a time)...", total, concurrency)
 	
 	var wg sync.WaitGroup
-	errChan := make(chan error, len(m.config.Tokens))
+	errs := make([]error, total) // Indexed by instance so failures stay attributable
+	slots := make(chan struct{}, concurrency)
 	
 	for i, token := range m.config.Tokens {
 		wg.Add(1)
 		go func(token string, index int) {
 			defer wg.Done()
 			
-			if err := m.startBot(token, index); err != nil {
-				errChan <- fmt.Errorf("failed to start bot %d: %w", index, err)
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				errs[index] = ctx.Err()
 				return
 			}
+			defer func() { <-slots }()
 			
-			// Simple staggered startup
+			if err := m.startBot(ctx, token, index); err != nil {
+				errs[index] = err
+				return
+			}
+			
+			// Simple staggered startup, holding the slot to space out logins
 			time.Sleep(500 * time.Millisecond)
 		}(token, i)
 	}
 	
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
+	wg.Wait()
 	
-	// Collect errors
-	var errors []error
-	for err := range errChan {
-		errors = append(errors, err)
-		log.Error(err)
+	// Report failures in instance order
+	startErr := &StartError{Total: total}
+	for index, err := range errs {
+		if err != nil {
+			startErr.Failures = append(startErr.Failures, &InstanceError{Index: index, Err: err})
+			log.Errorf("Instance %d failed to start: %v", index, err)
+		}
 	}
+	startErr.Started = total - len(startErr.Failures)
 	
-	successCount := len(m.config.Tokens) - len(errors)
-	log.Infof("Successfully started %d/%d bot instances", successCount, len(m.config.Tokens))
+	log.Infof("Successfully started %d/%d bot instances (%d failed)", startErr.Started, total, len(startErr.Failures))
 	
-	if len(errors) > 0 {
-		return fmt.Errorf("failed to start %d bots", len(errors))
+	if len(startErr.Failures) > 0 {
+		return startErr
 	}
 	
 	return nil
 }
 
 // startBot starts a single bot instance
-func (m *SimpleManager) startBot(token string, index int) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	
+func (m *SimpleManager) startBot(ctx context.Context, token string, index int) error {
 	bot := NewSimpleBot(m.config, m.database, token, index)
+	
+	m.mu.Lock()
 	m.bots[token] = bot
+	m.mu.Unlock()
 	
-	return bot.Start(context.Background())
+	return bot.Start(ctx)
 }
 
 // StopAll gracefully stops all bot instances
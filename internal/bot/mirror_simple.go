@@ -0,0 +1,173 @@
+package bot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"selfbot/internal/database"
+
+	"github.com/LightningDev1/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// mirrorClient downloads attachments for the mirror
+var mirrorClient = &http.Client{Timeout: 30 * time.Second}
+
+// mirrorAttachments saves attachments to disk, storing each unique file once by content hash
+func (b *SimpleBot) mirrorAttachments(attachments []*discordgo.MessageAttachment) []database.SimpleAttachmentMeta {
+	metas := make([]database.SimpleAttachmentMeta, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		meta := database.SimpleAttachmentMeta{
+			URL:      attachment.ProxyURL,
+			Filename: attachment.Filename,
+			Size:     int64(attachment.Size),
+		}
+
+		blob, err := b.mirrorAttachment(attachment)
+		if err != nil {
+			log.Warnf("Bot %d failed to mirror attachment %s: %v", b.index, attachment.Filename, err)
+		} else {
+			meta.Hash = blob.Hash
+			meta.BlobID = blob.ID
+			meta.Size = blob.Size
+		}
+
+		metas = append(metas, meta)
+	}
+
+	return metas
+}
+
+// mirrorAttachment downloads one attachment and links it to a new or existing blob
+func (b *SimpleBot) mirrorAttachment(attachment *discordgo.MessageAttachment) (*database.SimpleAttachmentBlob, error) {
+	dir := b.config.AttachmentMirror.Directory
+	maxSize := int64(b.config.AttachmentMirror.MaxSizeMB) << 20
+	if attachment.Size > 0 && int64(attachment.Size) > maxSize {
+		return nil, fmt.Errorf("attachment exceeds %d MB limit", b.config.AttachmentMirror.MaxSizeMB)
+	}
+
+	url := attachment.ProxyURL
+	if url == "" {
+		url = attachment.URL
+	}
+
+	tmpPath, hash, size, err := downloadAttachment(url, dir, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpPath) // No-op once the file has been moved into place
+
+	// A short read would otherwise be stored and shared under the wrong hash
+	if attachment.Size > 0 && size != int64(attachment.Size) {
+		return nil, fmt.Errorf("downloaded %d bytes, expected %d", size, attachment.Size)
+	}
+
+	// Try the plain hash first; on a collision fall back to an ID qualified by size
+	for _, id := range []string{hash, fmt.Sprintf("%s-%d", hash, size)} {
+		blob, err := b.database.GetAttachmentBlob(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if blob == nil {
+			newBlob := &database.SimpleAttachmentBlob{
+				ID:        id,
+				Hash:      hash,
+				Size:      size,
+				Path:      filepath.Join(dir, hash[:2], id),
+				RefCount:  1,
+				CreatedAt: time.Now(),
+			}
+			inserted, err := b.database.StoreAttachmentBlob(newBlob)
+			if err != nil {
+				return nil, err
+			}
+			if inserted {
+				// Only the instance that claimed the ID writes its file; if this fails,
+				// the next mirror of the same content repairs it below
+				if err := moveBlob(tmpPath, newBlob.Path); err != nil {
+					return nil, err
+				}
+				return newBlob, nil
+			}
+
+			// Another instance stored the same ID first; link to its record instead
+			if blob, err = b.database.GetAttachmentBlob(id); err != nil {
+				return nil, err
+			} else if blob == nil {
+				return nil, fmt.Errorf("blob %s disappeared after duplicate insert", id)
+			}
+		}
+
+		if blob.Size != size {
+			log.Warnf("Bot %d: SHA-256 collision on %s (%d vs %d bytes), storing separately", b.index, hash, blob.Size, size)
+			continue
+		}
+
+		// Repair the blob if its file went missing or was truncated on disk
+		if info, err := os.Stat(blob.Path); err != nil || info.Size() != size {
+			if err := moveBlob(tmpPath, blob.Path); err != nil {
+				return nil, fmt.Errorf("failed to repair blob %s: %w", blob.ID, err)
+			}
+		}
+
+		if err := b.database.AddAttachmentBlobRef(blob.ID); err != nil {
+			log.Errorf("Bot %d failed to link attachment to blob %s: %v", b.index, blob.ID, err)
+		}
+		return blob, nil
+	}
+
+	return nil, fmt.Errorf("unresolved hash collision for %s", hash)
+}
+
+// downloadAttachment streams url into a temp file in dir, returning its path, SHA-256 and size
+func downloadAttachment(url, dir string, maxSize int64) (string, string, int64, error) {
+	resp, err := mirrorClient.Get(url)
+	if err != nil {
+		return "", "", 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", 0, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", "", 0, err
+	}
+
+	tmp, err := os.CreateTemp(dir, "download-*")
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), io.LimitReader(resp.Body, maxSize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && size > maxSize {
+		err = fmt.Errorf("attachment exceeds size limit")
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", "", 0, err
+	}
+
+	return tmp.Name(), hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// moveBlob moves a downloaded file to its content-addressed path
+func moveBlob(tmpPath, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"selfbot/internal/config"
+)
+
+// kvModeToken is the leading argument that switches a command to key=value parsing
+const kvModeToken = "-kv"
+
+// useKeyValueArgs reports whether args should be parsed as key=value pairs,
+// stripping the leading mode token if present
+func useKeyValueArgs(cfg *config.Config, args []string) (bool, []string) {
+	if len(args) > 0 && strings.ToLower(args[0]) == kvModeToken {
+		return true, args[1:]
+	}
+	return cfg.KeyValueArgs, args
+}
+
+// parseKeyValueArgs splits args into key=value pairs and positional arguments.
+// Only words whose key is in keys are treated as pairs, so message text such
+// as a=b and flags such as -delete are passed through as positional words.
+// Quoted values that were split on whitespace are joined back together,
+// so msg="hello world" yields a single value. Keys are lowercased.
+// Keys listed in repeatable may be given more than once and collect every
+// value in order; any other duplicate key is an error.
+func parseKeyValueArgs(args []string, keys []string, repeatable ...string) (map[string][]string, []string, error) {
+	values := make(map[string][]string)
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		eq := strings.Index(arg, "=")
+		if eq <= 0 || !containsKey(keys, strings.ToLower(arg[:eq])) {
+			positional = append(positional, arg)
+			continue
+		}
+
+		key := strings.ToLower(arg[:eq])
+		value := arg[eq+1:]
+
+		if value != "" && (value[0] == '"' || value[0] == '\'') {
+			quote := value[:1]
+			value = value[1:]
+			for !strings.HasSuffix(value, quote) {
+				if i+1 >= len(args) {
+					return nil, nil, fmt.Errorf("unterminated quote in value for %s", key)
+				}
+				i++
+				value += " " + args[i]
+			}
+			value = strings.TrimSuffix(value, quote)
+		}
+
+		if _, exists := values[key]; exists && !containsKey(repeatable, key) {
+			return nil, nil, fmt.Errorf("duplicate key: %s", key)
+		}
+		values[key] = append(values[key], value)
+	}
+
+	return values, positional, nil
+}
+
+// containsKey checks if key is in keys
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,7 @@ type SimpleHandler struct {
 	bot      interfaces.BotInterface
 	config   *config.Config
 	commands map[string]SimpleCommand
+	reactors []ReactionCommand
 }
 
 // SimpleCommand interface for all commands
@@ -27,6 +28,12 @@ type SimpleCommand interface {
 	Description() string
 }
 
+// ReactionCommand is implemented by commands that respond to reactions
+type ReactionCommand interface {
+	// HandleReaction returns true if the reaction was consumed
+	HandleReaction(s *discordgo.Session, r *discordgo.MessageReactionAdd) bool
+}
+
 // NewSimpleHandler creates a new command handler
 func NewSimpleHandler(bot interfaces.BotInterface, cfg *config.Config) *SimpleHandler {
 	h := &SimpleHandler{
@@ -52,16 +59,22 @@ func (h *SimpleHandler) registerCommands() {
 		// Utility commands
 		NewSimplePingCommand(h.bot),
 		NewSimpleInfoCommand(h.bot),
+		NewSetupCommand(h.bot),
 		helpCmd,
 		
 		// Snipe commands
 		NewSimpleSnipeCommand(h.bot),
 		NewSimpleEditSnipeCommand(h.bot),
 		NewSimpleLastPingCommand(h.bot),
+		NewSimpleJoinsCommand(h.bot),
+		NewSimpleLeavesCommand(h.bot),
 		
 		// Presence command
 		NewSimplePresenceCommand(h.bot),
 		
+		// Diagnostics commands
+		NewSimpleRateLimitCommand(h.bot),
+		
 		// Spam commands
 		spamCmd,
 		stopSpamCmd,
@@ -72,6 +85,9 @@ func (h *SimpleHandler) registerCommands() {
 		for _, alias := range cmd.Aliases() {
 			h.commands[alias] = cmd
 		}
+		if reactor, ok := cmd.(ReactionCommand); ok {
+			h.reactors = append(h.reactors, reactor)
+		}
 	}
 	
 	// Set command map reference for help command
@@ -116,40 +132,74 @@ func (h *SimpleHandler) Handle(s *discordgo.Session, m *discordgo.MessageCreate)
 
 			if err := cmd.Execute(s, m, args); err != nil {
 				log.Errorf("Command %s error: %v", commandName, err)
+				if strings.Contains(err.Error(), "429") {
+					h.bot.GetRateLimits().RecordSource(cmd.Name())
+				}
 				h.sendErrorMessage(s, m.ChannelID, fmt.Sprintf("Error executing command: %v", err))
 			}
 		}()
 	}
 }
 
-// sendErrorMessage sends an error message with auto-delete
-func (h *SimpleHandler) sendErrorMessage(s *discordgo.Session, channelID, content string) {
-	msg, err := s.ChannelMessageSend(channelID, content)
-	if err != nil {
-		log.Errorf("Failed to send error message: %v", err)
+// HandleReaction routes the user's own reactions to reaction-aware commands
+func (h *SimpleHandler) HandleReaction(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+	if r.MessageReaction == nil || r.UserID != h.bot.GetUserID() {
 		return
 	}
 
-	if h.config.AutoDelete.Enabled {
-		time.AfterFunc(time.Duration(h.config.AutoDelete.Delay)*time.Second, func() {
-			s.ChannelMessageDelete(channelID, msg.ID)
-		})
+	for _, reactor := range h.reactors {
+		if reactor.HandleReaction(s, r) {
+			return
+		}
+	}
+}
+
+// sendErrorMessage sends an error message with auto-delete
+func (h *SimpleHandler) sendErrorMessage(s *discordgo.Session, channelID, content string) {
+	if err := SendError(h.bot, s, channelID, content); err != nil {
+		log.Errorf("Failed to send error message: %v", err)
 	}
 }
 
 // SendWithAutoDelete is a helper for commands to send messages with auto-delete
 func (h *SimpleHandler) SendWithAutoDelete(s *discordgo.Session, channelID, content string) {
+	if err := SendTempMessage(h.bot, s, channelID, content); err != nil {
+		log.Errorf("Failed to send message: %v", err)
+	}
+}
+
+// SendTempMessage sends a message and schedules it for auto-delete if configured
+func SendTempMessage(bot interfaces.BotInterface, s *discordgo.Session, channelID, content string) error {
 	msg, err := s.ChannelMessageSend(channelID, content)
 	if err != nil {
-		log.Errorf("Failed to send message: %v", err)
-		return
+		return err
 	}
 
-	if h.config.AutoDelete.Enabled {
-		time.AfterFunc(time.Duration(h.config.AutoDelete.Delay)*time.Second, func() {
+	if cfg := bot.GetConfig(); cfg.AutoDelete.Enabled {
+		time.AfterFunc(time.Duration(cfg.AutoDelete.Delay)*time.Second, func() {
 			s.ChannelMessageDelete(channelID, msg.ID)
 		})
 	}
+
+	return nil
+}
+
+// SendSuccess sends a temporary message prefixed with the configured success emoji
+func SendSuccess(bot interfaces.BotInterface, s *discordgo.Session, channelID, message string) error {
+	return SendTempMessage(bot, s, channelID, withEmoji(bot.GetConfig().UI.SuccessEmoji, message))
+}
+
+// SendError sends a temporary message prefixed with the configured error emoji
+func SendError(bot interfaces.BotInterface, s *discordgo.Session, channelID, message string) error {
+	return SendTempMessage(bot, s, channelID, withEmoji(bot.GetConfig().UI.ErrorEmoji, message))
+}
+
+// withEmoji prefixes a message with an emoji, leaving it untouched if the emoji is disabled
+func withEmoji(emoji, message string) string {
+	if emoji == "" {
+		return message
+	}
+	return emoji + " " + message
 }
 
 // Helper function to format messages with quote blocks (updated to match help formatting)
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"selfbot/internal/database"
+	"selfbot/internal/interfaces"
+
+	"github.com/LightningDev1/discordgo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SimpleMemberEventsCommand shows recent guild joins or leaves
+type SimpleMemberEventsCommand struct {
+	bot   interfaces.BotInterface
+	event string // "join" or "leave"
+}
+
+// NewSimpleJoinsCommand creates the joins command
+func NewSimpleJoinsCommand(bot interfaces.BotInterface) *SimpleMemberEventsCommand {
+	return &SimpleMemberEventsCommand{bot: bot, event: "join"}
+}
+
+// NewSimpleLeavesCommand creates the leaves command
+func NewSimpleLeavesCommand(bot interfaces.BotInterface) *SimpleMemberEventsCommand {
+	return &SimpleMemberEventsCommand{bot: bot, event: "leave"}
+}
+
+func (c *SimpleMemberEventsCommand) Name() string {
+	if c.event == "join" {
+		return "joins"
+	}
+	return "leaves"
+}
+
+func (c *SimpleMemberEventsCommand) Aliases() []string { return []string{} }
+
+func (c *SimpleMemberEventsCommand) Description() string {
+	if c.event == "join" {
+		return "Show recent member joins"
+	}
+	return "Show recent member leaves"
+}
+
+// Execute shows recent events for the current guild, or a guild given by ID
+func (c *SimpleMemberEventsCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
+	cfg := c.bot.GetConfig()
+	if !cfg.MemberTracking.Enabled {
+		return SendError(c.bot, s, m.ChannelID, "Member tracking is disabled in config")
+	}
+
+	var limit int64 = 10
+	guildID := m.GuildID
+
+	for _, arg := range args {
+		if num, err := strconv.ParseInt(arg, 10, 64); err == nil {
+			if len(arg) > 15 { // Likely a guild ID
+				guildID = arg
+			} else {
+				limit = num
+			}
+		}
+	}
+
+	if limit < 1 {
+		limit = 1
+	} else if limit > 100 {
+		limit = 100
+	}
+
+	filter := bson.M{
+		"event":       c.event,
+		"instance_id": c.bot.GetUserID(),
+	}
+	if guildID != "" {
+		filter["guild_id"] = guildID
+	}
+
+	events, err := c.bot.GetDatabase().GetMemberEvents(filter, limit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch member events: %w", err)
+	}
+
+	if len(events) == 0 {
+		content := "```ansi\n" +
+			"\u001b[1;35mNo Events Found\n" +
+			"\u001b[0;37m─────────────────\n" +
+			fmt.Sprintf("\u001b[0;37mNo %ss recorded```", c.event)
+		return SendTempMessage(c.bot, s, m.ChannelID, FormatMessage(content))
+	}
+
+	// Without a guild filter events from every tracked guild are mixed, so label each one
+	return c.formatAndSendEvents(s, m.ChannelID, events, guildID == "")
+}
+
+// formatAndSendEvents formats member events in chunks
+func (c *SimpleMemberEventsCommand) formatAndSendEvents(s *discordgo.Session, channelID string, events []database.SimpleMemberEventData, showGuild bool) error {
+	const chunkSize = 10
+
+	title := "Recent Joins"
+	if c.event == "leave" {
+		title = "Recent Leaves"
+	}
+
+	for chunkStart := 0; chunkStart < len(events); chunkStart += chunkSize {
+		chunkEnd := chunkStart + chunkSize
+		if chunkEnd > len(events) {
+			chunkEnd = len(events)
+		}
+
+		content := fmt.Sprintf("```ansi\n\u001b[30m\u001b[1m\u001b[4m%s\u001b[0m\n", title)
+
+		for idx, event := range events[chunkStart:chunkEnd] {
+			username := event.Username
+			if username == "" {
+				username = "Unknown User"
+			}
+
+			content += fmt.Sprintf("\u001b[1;33m#%d\n", chunkStart+idx+1)
+			content += fmt.Sprintf("\u001b[1;37m%s \u001b[0m(%s)\n", username, event.UserID)
+			content += fmt.Sprintf("\u001b[0;37m%s\n", event.EventAt.Format("Jan 2 3:04 PM"))
+			if showGuild {
+				content += fmt.Sprintf("\u001b[0;35mGuild: %s\n", guildLabel(s, event.GuildID))
+			}
+
+			if !event.AccountCreatedAt.IsZero() {
+				age := formatDuration(event.EventAt.Sub(event.AccountCreatedAt))
+				if event.IsNewAccount {
+					content += fmt.Sprintf("\u001b[1;31mAccount age: %s ⚠ NEW\n", age)
+				} else {
+					content += fmt.Sprintf("\u001b[0;36mAccount age: %s\n", age)
+				}
+			}
+
+			content += "\u001b[0;37m────────────────────────────\n"
+		}
+
+		content += "```"
+
+		if err := SendTempMessage(c.bot, s, channelID, FormatMessage(content)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// guildLabel returns the guild name from state with its ID, or just the ID if it isn't cached
+func guildLabel(s *discordgo.Session, guildID string) string {
+	if guild, err := s.State.Guild(guildID); err == nil && guild != nil && guild.Name != "" {
+		return fmt.Sprintf("%s (%s)", guild.Name, guildID)
+	}
+	return guildID
+}
@@ -0,0 +1,319 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"selfbot/internal/config"
+	"selfbot/internal/interfaces"
+
+	"github.com/LightningDev1/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// setupReplyTimeout is how long the setup command waits for each answer
+const setupReplyTimeout = 2 * time.Minute
+
+// errSetupCancelled is returned when the user aborts the setup sequence
+var errSetupCancelled = errors.New("setup cancelled")
+
+// Setup writes the shared config file, so only one instance may run it at a time
+var (
+	setupMu      sync.Mutex
+	setupRunning bool
+)
+
+// SetupCommand walks the user through writing a config file
+type SetupCommand struct {
+	bot interfaces.BotInterface
+}
+
+// NewSetupCommand creates a new setup command
+func NewSetupCommand(bot interfaces.BotInterface) *SetupCommand {
+	return &SetupCommand{bot: bot}
+}
+
+func (c *SetupCommand) Name() string        { return "setup" }
+func (c *SetupCommand) Aliases() []string   { return []string{"init"} }
+func (c *SetupCommand) Description() string { return "Guided config file setup" }
+
+// setupSession holds the state of a single setup sequence
+type setupSession struct {
+	s         *discordgo.Session
+	channelID string
+	replies   chan string
+	prompts   []string // Prompt message IDs, deleted when setup ends
+
+	mu      sync.Mutex
+	pending map[string]int // Prompt contents not yet echoed back by the gateway
+}
+
+// Execute runs the setup sequence in the current channel, which must be a DM
+// since the answers include the database URI
+func (c *SetupCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
+	if m.GuildID != "" {
+		return SendError(c.bot, s, m.ChannelID, "Setup asks for credentials, run it in your DMs")
+	}
+	if channel, err := s.Channel(m.ChannelID); err != nil {
+		return fmt.Errorf("failed to fetch channel: %w", err)
+	} else if channel.Type != discordgo.ChannelTypeDM {
+		return SendError(c.bot, s, m.ChannelID, "Setup asks for credentials, run it in your DMs")
+	}
+
+	setupMu.Lock()
+	if setupRunning {
+		setupMu.Unlock()
+		return SendError(c.bot, s, m.ChannelID, "Setup is already running")
+	}
+	setupRunning = true
+	setupMu.Unlock()
+
+	defer func() {
+		setupMu.Lock()
+		setupRunning = false
+		setupMu.Unlock()
+	}()
+
+	session := &setupSession{
+		s:         s,
+		channelID: m.ChannelID,
+		replies:   make(chan string, 1),
+		pending:   make(map[string]int),
+	}
+
+	// Keep replies out of the message log, since they may contain credentials
+	resumeLogging := c.bot.PauseMessageLogging(m.ChannelID)
+	defer resumeLogging()
+
+	// Capture the user's replies in this channel, deleting them for the same reason
+	userID := c.bot.GetUserID()
+	removeHandler := s.AddHandler(func(s *discordgo.Session, reply *discordgo.MessageCreate) {
+		if reply.Author == nil || reply.Author.ID != userID || reply.ChannelID != session.channelID || reply.ID == m.ID {
+			return
+		}
+		// Our own prompts arrive as messages from the same account
+		if session.consumePrompt(reply.Content) {
+			return
+		}
+
+		select {
+		case session.replies <- strings.TrimSpace(reply.Content):
+		default: // An answer is already queued, but still remove this reply
+		}
+		go s.ChannelMessageDelete(reply.ChannelID, reply.ID)
+	})
+	defer removeHandler()
+	defer session.cleanup()
+
+	values, err := c.collect(session)
+	removeHandler() // Stop capturing before sending the result
+	if errors.Is(err, errSetupCancelled) {
+		return SendError(c.bot, s, m.ChannelID, "Setup cancelled, no changes were written")
+	}
+	if err != nil {
+		return SendError(c.bot, s, m.ChannelID, err.Error())
+	}
+
+	filename := config.ConfigFile()
+	if err := config.WriteSetup(filename, values); err != nil {
+		return SendError(c.bot, s, m.ChannelID, err.Error())
+	}
+
+	log.Infof("Setup wrote config file %s", filename)
+	return SendSuccess(c.bot, s, m.ChannelID, fmt.Sprintf("Config written to `%s`. Restart to apply changes.", filename))
+}
+
+// collect confirms the overwrite, then asks each setup question in turn starting from the current config
+func (c *SetupCommand) collect(session *setupSession) (config.SetupValues, error) {
+	cfg := c.bot.GetConfig()
+	values := config.SetupValues{
+		CommandPrefix:     cfg.CommandPrefix,
+		DatabaseURI:       cfg.Database.URI,
+		AutoDeleteEnabled: cfg.AutoDelete.Enabled,
+		AutoDeleteDelay:   cfg.AutoDelete.Delay,
+		PresenceEnabled:   cfg.Presence.Enabled,
+		PresenceStatus:    cfg.Presence.Status,
+	}
+
+	answer, err := session.ask(fmt.Sprintf("This replaces the config file at `%s` for all accounts. Continue? (yes/no)", config.ConfigFile()), validateYesNo)
+	if err != nil {
+		return values, err
+	}
+	if answer != "yes" {
+		return values, errSetupCancelled
+	}
+
+	answer, err = session.ask(fmt.Sprintf("Command prefix? (current: `%s`)", values.CommandPrefix), validatePrefix)
+	if err != nil {
+		return values, err
+	}
+	if answer != "" {
+		values.CommandPrefix = answer
+	}
+
+	answer, err = session.ask("MongoDB URI? (current value hidden)", validateDatabaseURI)
+	if err != nil {
+		return values, err
+	}
+	if answer != "" {
+		values.DatabaseURI = answer
+	}
+
+	answer, err = session.ask(fmt.Sprintf("Auto-delete delay in seconds, or `off`? (current: %s)", describeAutoDelete(values)), validateAutoDelete)
+	if err != nil {
+		return values, err
+	}
+	if answer == "off" {
+		values.AutoDeleteEnabled = false
+	} else if answer != "" {
+		values.AutoDeleteEnabled = true
+		values.AutoDeleteDelay, _ = strconv.Atoi(answer)
+	}
+
+	answer, err = session.ask(fmt.Sprintf("Presence status (online, idle, dnd, invisible), or `off`? (current: %s)", describePresence(values)), validatePresence)
+	if err != nil {
+		return values, err
+	}
+	if answer == "off" {
+		values.PresenceEnabled = false
+	} else if answer != "" {
+		values.PresenceEnabled = true
+		values.PresenceStatus = answer
+	}
+
+	return values, nil
+}
+
+// ask sends a prompt and waits for a valid reply, re-prompting on invalid input.
+// An empty result means the user skipped the question.
+func (p *setupSession) ask(prompt string, validate func(string) (string, error)) (string, error) {
+	p.send(prompt + "\n-# Reply `skip` to keep the current value or `cancel` to stop.")
+
+	for {
+		select {
+		case reply := <-p.replies:
+			switch strings.ToLower(reply) {
+			case "cancel":
+				return "", errSetupCancelled
+			case "skip":
+				return "", nil
+			}
+
+			answer, err := validate(reply)
+			if err != nil {
+				p.send(fmt.Sprintf("Invalid value: %v. Try again.", err))
+				continue
+			}
+			return answer, nil
+		case <-time.After(setupReplyTimeout):
+			return "", fmt.Errorf("setup timed out waiting for a reply")
+		}
+	}
+}
+
+// send posts a prompt message and tracks it for cleanup
+func (p *setupSession) send(content string) {
+	p.mu.Lock()
+	p.pending[content]++
+	p.mu.Unlock()
+
+	msg, err := p.s.ChannelMessageSend(p.channelID, content)
+	if err != nil {
+		log.Errorf("Failed to send setup prompt: %v", err)
+		p.consumePrompt(content)
+		return
+	}
+	p.prompts = append(p.prompts, msg.ID)
+}
+
+// consumePrompt reports whether content is a prompt we sent, marking it as seen
+func (p *setupSession) consumePrompt(content string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pending[content] == 0 {
+		return false
+	}
+	p.pending[content]--
+	return true
+}
+
+// cleanup deletes all prompt messages sent during setup
+func (p *setupSession) cleanup() {
+	for _, id := range p.prompts {
+		if err := p.s.ChannelMessageDelete(p.channelID, id); err != nil {
+			log.Debugf("Failed to delete setup prompt: %v", err)
+		}
+	}
+}
+
+// validateYesNo accepts yes/no style answers
+func validateYesNo(answer string) (string, error) {
+	switch strings.ToLower(answer) {
+	case "yes", "y":
+		return "yes", nil
+	case "no", "n":
+		return "no", nil
+	}
+	return "", fmt.Errorf("answer yes or no")
+}
+
+// validatePrefix accepts a short prefix without whitespace
+func validatePrefix(answer string) (string, error) {
+	if answer == "" || len(answer) > 5 || strings.ContainsAny(answer, " \t\n") {
+		return "", fmt.Errorf("prefix must be 1-5 characters without spaces")
+	}
+	return answer, nil
+}
+
+// validateDatabaseURI accepts MongoDB connection strings
+func validateDatabaseURI(answer string) (string, error) {
+	if !strings.HasPrefix(answer, "mongodb://") && !strings.HasPrefix(answer, "mongodb+srv://") {
+		return "", fmt.Errorf("URI must start with mongodb:// or mongodb+srv://")
+	}
+	return answer, nil
+}
+
+// validateAutoDelete accepts `off` or a delay between 1 and 3600 seconds
+func validateAutoDelete(answer string) (string, error) {
+	if strings.ToLower(answer) == "off" {
+		return "off", nil
+	}
+	delay, err := strconv.Atoi(answer)
+	if err != nil || delay < 1 || delay > 3600 {
+		return "", fmt.Errorf("delay must be a number between 1 and 3600, or off")
+	}
+	return strconv.Itoa(delay), nil
+}
+
+// validatePresence accepts `off` or a Discord status
+func validatePresence(answer string) (string, error) {
+	switch status := strings.ToLower(answer); status {
+	case "off", "online", "idle", "dnd", "invisible":
+		return status, nil
+	}
+	return "", fmt.Errorf("status must be online, idle, dnd, invisible, or off")
+}
+
+// describeAutoDelete renders the current auto-delete setting for prompts
+func describeAutoDelete(values config.SetupValues) string {
+	if !values.AutoDeleteEnabled {
+		return "off"
+	}
+	return fmt.Sprintf("%ds", values.AutoDeleteDelay)
+}
+
+// describePresence renders the current presence setting for prompts
+func describePresence(values config.SetupValues) string {
+	if !values.PresenceEnabled {
+		return "off"
+	}
+	if values.PresenceStatus == "" {
+		return "dnd"
+	}
+	return values.PresenceStatus
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"selfbot/internal/database"
@@ -17,11 +18,18 @@ import (
 // SimpleSnipeCommand implements the snipe command with clean Go patterns
 type SimpleSnipeCommand struct {
 	bot interfaces.BotInterface
+
+	// Newest deletion shown per channel, used to mark new entries on the next snipe
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
 }
 
 // NewSimpleSnipeCommand creates a new snipe command
 func NewSimpleSnipeCommand(bot interfaces.BotInterface) *SimpleSnipeCommand {
-	return &SimpleSnipeCommand{bot: bot}
+	return &SimpleSnipeCommand{
+		bot:      bot,
+		lastSeen: make(map[string]time.Time),
+	}
 }
 
 // Name returns the command name
@@ -39,6 +47,9 @@ func (c *SimpleSnipeCommand) Description() string {
 	return "Show deleted messages"
 }
 
+// snipeKeys are the keys accepted in key=value mode
+var snipeKeys = []string{"user", "u", "channel", "c", "amount", "n"}
+
 // Execute executes the snipe command with simplified logic
 func (c *SimpleSnipeCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
 	// Parse arguments with simple approach
@@ -46,6 +57,33 @@ func (c *SimpleSnipeCommand) Execute(s *discordgo.Session, m *discordgo.MessageC
 	var channelID string = m.ChannelID
 	var limit int64 = 1
 
+	kvMode, args := useKeyValueArgs(c.bot.GetConfig(), args)
+	if kvMode {
+		values, positional, err := parseKeyValueArgs(args, snipeKeys)
+		if err != nil {
+			return SendError(c.bot, s, m.ChannelID, err.Error())
+		}
+
+		for key, vals := range values {
+			value := vals[0]
+			switch key {
+			case "user", "u":
+				userID = strings.Trim(value, "<@!>")
+			case "channel", "c":
+				channelID = strings.Trim(value, "<#>")
+			case "amount", "n":
+				num, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return SendError(c.bot, s, m.ChannelID, "Amount must be a number")
+				}
+				limit = num
+			}
+		}
+
+		// Words that aren't key=value pairs use the regular parsing below
+		args = positional
+	}
+
 	for i, arg := range args {
 		// Check if it's a user mention
 		if strings.HasPrefix(arg, "<@") && strings.HasSuffix(arg, ">") {
@@ -120,101 +158,188 @@ func (c *SimpleSnipeCommand) Execute(s *discordgo.Session, m *discordgo.MessageC
 		return nil
 	}
 
+	c.mu.Lock()
+	since := c.lastSeen[channelID]
+	c.mu.Unlock()
+
 	// Format and send messages with simple approach
-	return c.formatAndSendMessages(s, m.ChannelID, messages)
+	if err := c.formatAndSendMessages(s, m.ChannelID, messages, since); err != nil {
+		return err
+	}
+
+	c.markSeen(channelID, messages)
+	return nil
 }
 
-// formatAndSendMessages formats and sends deleted messages with clean logic
-func (c *SimpleSnipeCommand) formatAndSendMessages(s *discordgo.Session, channelID string, messages []database.SimpleDeletedMessageData) error {
-	const chunkSize = 10 // Process in chunks
+// markSeen advances the channel's marker to the newest deletion shown
+func (c *SimpleSnipeCommand) markSeen(channelID string, messages []database.SimpleDeletedMessageData) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	for chunkStart := 0; chunkStart < len(messages); chunkStart += chunkSize {
-		chunkEnd := chunkStart + chunkSize
-		if chunkEnd > len(messages) {
-			chunkEnd = len(messages)
+	for _, msg := range messages {
+		if msg.DeletedAt.After(c.lastSeen[channelID]) {
+			c.lastSeen[channelID] = msg.DeletedAt
 		}
+	}
+}
 
-		chunk := messages[chunkStart:chunkEnd]
-		
-		// Build message content
-		content := "```ansi\n\u001b[30m\u001b[1m\u001b[4mDeleted Messages\u001b[0m\n"
-		attachments := []string{}
+// formatAndSendMessages formats and sends deleted messages with clean logic.
+// Entries deleted after since are marked as new; a zero since marks nothing.
+func (c *SimpleSnipeCommand) formatAndSendMessages(s *discordgo.Session, channelID string, messages []database.SimpleDeletedMessageData, since time.Time) error {
+	const (
+		chunkSize        = 10   // Entries per message
+		maxMessageLength = 2000 // Discord message limit
+		sendDelay        = 300 * time.Millisecond
+	)
+
+	const header = "```ansi\n\u001b[30m\u001b[1m\u001b[4mDeleted Messages\u001b[0m\n"
+	interleave := c.bot.GetConfig().Snipe.AttachmentMode != "grouped"
+
+	var block strings.Builder
+	entries := 0
+	sends := 0
+	var grouped []string // Labelled attachments awaiting a grouped send
+
+	// send paces consecutive sends so interleaved output doesn't burst the channel bucket
+	send := func(content string) error {
+		if sends > 0 {
+			time.Sleep(sendDelay)
+		}
+		sends++
+		return SendTempMessage(c.bot, s, channelID, content)
+	}
 
-		for idx, msg := range chunk {
-			num := chunkStart + idx + 1
-			username := msg.Username
-			if username == "" {
-				username = "Unknown User"
-			}
+	flushText := func() error {
+		if entries == 0 {
+			return nil
+		}
+		content := FormatMessage(header + block.String() + "```")
+		block.Reset()
+		entries = 0
+		return send(content)
+	}
 
-			msgContent := CleanContent(msg.Content)
-			msgContent = TruncateContent(msgContent, 256)
+	sendAttachments := func(lines []string) {
+		for _, content := range joinWithinLimit(lines, maxMessageLength) {
+			if err := send(content); err != nil {
+				log.Errorf("Failed to send attachments: %v", err)
+			}
+		}
+	}
 
-			timestamp := msg.DeletedAt.Format("3:04 PM")
+	for idx, msg := range messages {
+		num := idx + 1
+		entry := c.formatEntry(num, msg, since)
 
-			content += fmt.Sprintf("\u001b[1;33m#%d\n", num)
-			content += fmt.Sprintf("\u001b[1;37m%s \u001b[0mToday at %s\n", username, timestamp)
-			
-			if msgContent != "" {
-				for _, line := range strings.Split(msgContent, "\n") {
-					content += fmt.Sprintf("\u001b[1;31m%s\n", line)
-				}
+		// Start a new message when the chunk is full or the entry would overflow it
+		if entries > 0 && (entries == chunkSize || len(FormatMessage(header+block.String()+entry+"```")) > maxMessageLength) {
+			if err := flushText(); err != nil {
+				return err
 			}
-
-			// Handle attachments
-			if len(msg.Attachments) > 0 {
-				if len(msg.Attachments) == 1 {
-					content += "\u001b[0;36m└─── [ 1 Attachment ]\n"
-				} else {
-					content += fmt.Sprintf("\u001b[0;36m└─── [ %d Attachments ]\n", len(msg.Attachments))
-				}
-				
-				attachments = append(attachments, msg.Attachments...)
+			if len(grouped) > 0 {
+				sendAttachments(grouped)
+				grouped = nil
 			}
+		}
 
-			// Add location info
-			location := "Unknown"
-			if msg.GuildName != "" && msg.ChannelName != "" {
-				location = fmt.Sprintf("#%s in %s", msg.ChannelName, msg.GuildName)
-			} else if msg.ChannelType == "group" {
-				location = "Group chat"
-			} else if msg.ChannelType == "DMs" {
-				location = fmt.Sprintf("DM with %s", username)
-			}
+		block.WriteString(entry)
+		entries++
 
-			content += fmt.Sprintf("\u001b[0;36m%s\n", location)
-			content += "\u001b[0;37m────────────────────────────\n"
+		if len(msg.Attachments) == 0 {
+			continue
 		}
 
-		content += "```"
-
-		// Send formatted message
-		msg, err := s.ChannelMessageSend(channelID, FormatMessage(content))
-		if err != nil {
-			return err
+		label := fmt.Sprintf("`#%d`", num)
+		if interleave {
+			// Send this entry's text, then its attachments, before moving on
+			if err := flushText(); err != nil {
+				return err
+			}
+			sendAttachments(append([]string{label}, msg.Attachments...))
+		} else {
+			grouped = append(grouped, label)
+			grouped = append(grouped, msg.Attachments...)
 		}
+	}
 
-		// Auto-delete if configured
-		if c.bot.GetConfig().AutoDelete.Enabled {
-			time.AfterFunc(time.Duration(c.bot.GetConfig().AutoDelete.Delay)*time.Second, func() {
-				s.ChannelMessageDelete(channelID, msg.ID)
-			})
+	if err := flushText(); err != nil {
+		return err
+	}
+	if len(grouped) > 0 {
+		sendAttachments(grouped)
+	}
+
+	return nil
+}
+
+// formatEntry renders a single deleted message for the snipe output
+func (c *SimpleSnipeCommand) formatEntry(num int, msg database.SimpleDeletedMessageData, since time.Time) string {
+	username := msg.Username
+	if username == "" {
+		username = "Unknown User"
+	}
+
+	msgContent := CleanContent(msg.Content)
+	msgContent = TruncateContent(msgContent, 256)
+
+	timestamp := msg.DeletedAt.Format("3:04 PM")
+
+	var content string
+	if !since.IsZero() && msg.DeletedAt.After(since) {
+		content += fmt.Sprintf("\u001b[1;33m#%d 🆕\n", num)
+	} else {
+		content += fmt.Sprintf("\u001b[1;33m#%d\n", num)
+	}
+	content += fmt.Sprintf("\u001b[1;37m%s \u001b[0mToday at %s\n", username, timestamp)
+
+	if msgContent != "" {
+		for _, line := range strings.Split(msgContent, "\n") {
+			content += fmt.Sprintf("\u001b[1;31m%s\n", line)
 		}
+	}
 
-		// Send attachments if any
-		if len(attachments) > 0 {
-			attachmentMsg, err := s.ChannelMessageSend(channelID, strings.Join(attachments, "\n"))
-			if err != nil {
-				log.Errorf("Failed to send attachments: %v", err)
-			} else if c.bot.GetConfig().AutoDelete.Enabled {
-				time.AfterFunc(time.Duration(c.bot.GetConfig().AutoDelete.Delay)*time.Second, func() {
-					s.ChannelMessageDelete(channelID, attachmentMsg.ID)
-				})
-			}
+	// Handle attachments
+	if len(msg.Attachments) == 1 {
+		content += "\u001b[0;36m└─── [ 1 Attachment ]\n"
+	} else if len(msg.Attachments) > 1 {
+		content += fmt.Sprintf("\u001b[0;36m└─── [ %d Attachments ]\n", len(msg.Attachments))
+	}
+
+	// Add location info
+	location := "Unknown"
+	if msg.GuildName != "" && msg.ChannelName != "" {
+		location = fmt.Sprintf("#%s in %s", msg.ChannelName, msg.GuildName)
+	} else if msg.ChannelType == "group" {
+		location = "Group chat"
+	} else if msg.ChannelType == "DMs" {
+		location = fmt.Sprintf("DM with %s", username)
+	}
+
+	content += fmt.Sprintf("\u001b[0;36m%s\n", location)
+	content += "\u001b[0;37m────────────────────────────\n"
+	return content
+}
+
+// joinWithinLimit joins lines with newlines into as few messages as fit within limit
+func joinWithinLimit(lines []string, limit int) []string {
+	var messages []string
+	var current strings.Builder
+
+	for _, line := range lines {
+		if current.Len() > 0 && current.Len()+1+len(line) > limit {
+			messages = append(messages, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n")
 		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		messages = append(messages, current.String())
 	}
 
-	return nil
+	return messages
 }
 
 // Placeholder implementations for other simple commands
@@ -737,25 +862,13 @@ func (c *SimplePresenceCommand) sendPresenceUsage(s *discordgo.Session, channelI
 }
 
 func (c *SimplePresenceCommand) sendError(s *discordgo.Session, channelID, message string) error {
-	return c.sendTempMessage(s, channelID, "❌ "+message)
+	return SendError(c.bot, s, channelID, message)
 }
 
 func (c *SimplePresenceCommand) sendSuccess(s *discordgo.Session, channelID, message string) error {
-	return c.sendTempMessage(s, channelID, "✅ "+message)
+	return SendSuccess(c.bot, s, channelID, message)
 }
 
 func (c *SimplePresenceCommand) sendTempMessage(s *discordgo.Session, channelID, content string) error {
-	msg, err := s.ChannelMessageSend(channelID, content)
-	if err != nil {
-		return err
-	}
-
-	if c.bot.GetConfig().AutoDelete.Enabled {
-		go func() {
-			time.Sleep(time.Duration(c.bot.GetConfig().AutoDelete.Delay) * time.Second)
-			s.ChannelMessageDelete(channelID, msg.ID)
-		}()
-	}
-
-	return nil
+	return SendTempMessage(c.bot, s, channelID, content)
 }
\ No newline at end of file
@@ -21,6 +21,19 @@ type SpamCommand struct {
 	mu          sync.RWMutex
 	isSpamming  map[string]bool  // Track spam status per channel
 	cancelFuncs map[string]context.CancelFunc // Cancel functions for stopping spam
+	statusMsgs  map[string]string // Status message ID -> target channel ID
+}
+
+// spamStopEmoji is the reaction that cancels spam from its status message
+const spamStopEmoji = "🛑"
+
+// spamProgressInterval is how many sent messages pass between status edits
+const spamProgressInterval = 10
+
+// spamStatus identifies the progress message posted for a spam run
+type spamStatus struct {
+	channelID string
+	messageID string
 }
 
 // NewSpamCommand creates a new spam command
@@ -29,6 +42,7 @@ func NewSpamCommand(bot interfaces.BotInterface) *SpamCommand {
 		bot:         bot,
 		isSpamming:  make(map[string]bool),
 		cancelFuncs: make(map[string]context.CancelFunc),
+		statusMsgs:  make(map[string]string),
 	}
 }
 
@@ -49,20 +63,36 @@ type SpamOptions struct {
 
 // Execute executes the spam command with clean Go logic
 func (c *SpamCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
-	if len(args) < 2 {
-		return c.sendUsage(s, m.ChannelID)
-	}
+	kvMode, args := useKeyValueArgs(c.bot.GetConfig(), args)
 
-	// Parse amount
-	amount, err := strconv.Atoi(args[0])
-	if err != nil || amount < 1 || amount > 1000 {
-		return c.sendError(s, m.ChannelID, "Amount must be a number between 1 and 1000")
-	}
+	var opts *SpamOptions
+	if kvMode {
+		if len(args) == 0 {
+			return c.sendUsage(s, m.ChannelID)
+		}
 
-	// Parse options
-	opts, err := c.parseSpamOptions(amount, args[1:])
-	if err != nil {
-		return c.sendError(s, m.ChannelID, err.Error())
+		// Parse key=value options
+		var err error
+		opts, err = c.parseKeyValueOptions(args)
+		if err != nil {
+			return c.sendError(s, m.ChannelID, err.Error())
+		}
+	} else {
+		if len(args) < 2 {
+			return c.sendUsage(s, m.ChannelID)
+		}
+
+		// Parse amount
+		amount, err := strconv.Atoi(args[0])
+		if err != nil || amount < 1 || amount > 1000 {
+			return c.sendError(s, m.ChannelID, "Amount must be a number between 1 and 1000")
+		}
+
+		// Parse options
+		opts, err = c.parseSpamOptions(amount, args[1:])
+		if err != nil {
+			return c.sendError(s, m.ChannelID, err.Error())
+		}
 	}
 
 	// Set default channel
@@ -90,11 +120,72 @@ func (c *SpamCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate,
 	c.cancelFuncs[opts.ChannelID] = cancel
 	c.mu.Unlock()
 
-	go c.executeSpam(ctx, s, opts)
+	status := c.sendStatus(s, m.ChannelID, opts)
+
+	go c.executeSpam(ctx, s, opts, status)
 
 	return nil
 }
 
+// sendStatus posts the progress message and tracks it for reaction stops
+func (c *SpamCommand) sendStatus(s *discordgo.Session, channelID string, opts *SpamOptions) *spamStatus {
+	msg, err := s.ChannelMessageSend(channelID, c.formatStatus(opts, 0, "Spamming"))
+	if err != nil {
+		log.Debugf("Failed to send spam status message: %v", err)
+		return nil
+	}
+
+	c.mu.Lock()
+	c.statusMsgs[msg.ID] = opts.ChannelID
+	c.mu.Unlock()
+
+	return &spamStatus{channelID: channelID, messageID: msg.ID}
+}
+
+// updateStatus edits the progress message, ignoring failures
+func (c *SpamCommand) updateStatus(s *discordgo.Session, status *spamStatus, content string) {
+	if status == nil {
+		return
+	}
+	if _, err := s.ChannelMessageEdit(status.channelID, status.messageID, content); err != nil {
+		log.Debugf("Failed to update spam status message: %v", err)
+	}
+}
+
+// formatStatus renders the progress line shown in the status message
+func (c *SpamCommand) formatStatus(opts *SpamOptions, sent int, state string) string {
+	content := fmt.Sprintf("%s %d/%d messages in <#%s>", state, sent, opts.Amount, opts.ChannelID)
+	if state == "Spamming" {
+		content += fmt.Sprintf(" — react with %s to stop", spamStopEmoji)
+	}
+	return content
+}
+
+// HandleReaction stops spam when the stop emoji is added to a status message
+func (c *SpamCommand) HandleReaction(s *discordgo.Session, r *discordgo.MessageReactionAdd) bool {
+	if r.Emoji.Name != spamStopEmoji {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	channelID, exists := c.statusMsgs[r.MessageID]
+	if !exists {
+		return false
+	}
+
+	if cancel, exists := c.cancelFuncs[channelID]; exists {
+		cancel()
+		delete(c.isSpamming, channelID)
+		delete(c.cancelFuncs, channelID)
+	}
+	delete(c.statusMsgs, r.MessageID)
+
+	log.Infof("Spam in channel %s stopped by reaction", channelID)
+	return true
+}
+
 // parseSpamOptions parses command arguments into SpamOptions
 func (c *SpamCommand) parseSpamOptions(amount int, args []string) (*SpamOptions, error) {
 	opts := &SpamOptions{
@@ -103,6 +194,25 @@ func (c *SpamCommand) parseSpamOptions(amount int, args []string) (*SpamOptions,
 		Delay:    0,
 	}
 
+	if err := c.applySpamArgs(opts, args); err != nil {
+		return nil, err
+	}
+
+	// If no messages collected, something went wrong
+	if len(opts.Messages) == 0 {
+		return nil, fmt.Errorf("no message content provided")
+	}
+
+	// Apply max length if requested
+	if opts.UseMax {
+		opts.Messages = c.applyMaxLength(opts.Messages)
+	}
+
+	return opts, nil
+}
+
+// applySpamArgs applies flags in args to opts and appends the remaining words as messages
+func (c *SpamCommand) applySpamArgs(opts *SpamOptions, args []string) error {
 	var currentMessage strings.Builder
 	i := 0
 
@@ -118,17 +228,17 @@ func (c *SpamCommand) parseSpamOptions(amount int, args []string) (*SpamOptions,
 			opts.UseRandom = true
 		case "-d", "-delay":
 			if i+1 >= len(args) {
-				return nil, fmt.Errorf("missing delay value after %s", arg)
+				return fmt.Errorf("missing delay value after %s", arg)
 			}
 			i++
 			delaySeconds, err := strconv.Atoi(args[i])
 			if err != nil || delaySeconds < 0 || delaySeconds > 3600 {
-				return nil, fmt.Errorf("delay must be a number between 0 and 3600 seconds")
+				return fmt.Errorf("delay must be a number between 0 and 3600 seconds")
 			}
 			opts.Delay = time.Duration(delaySeconds) * time.Second
 		case "-c", "-channel":
 			if i+1 >= len(args) {
-				return nil, fmt.Errorf("missing channel ID after %s", arg)
+				return fmt.Errorf("missing channel ID after %s", arg)
 			}
 			i++
 			opts.ChannelID = args[i]
@@ -154,12 +264,92 @@ func (c *SpamCommand) parseSpamOptions(amount int, args []string) (*SpamOptions,
 		opts.Messages = append(opts.Messages, strings.TrimSpace(currentMessage.String()))
 	}
 
-	// If no messages collected, something went wrong
+	return nil
+}
+
+// spamKeys are the keys accepted in key=value mode
+var spamKeys = []string{"amount", "n", "msg", "message", "delay", "d", "channel", "c", "max", "delete", "random", "r"}
+
+// parseKeyValueOptions parses key=value arguments into SpamOptions.
+// Positional arguments fill in the amount when that key is absent, and the
+// remaining words are parsed like regular arguments so flags keep working.
+func (c *SpamCommand) parseKeyValueOptions(args []string) (*SpamOptions, error) {
+	values, positional, err := parseKeyValueArgs(args, spamKeys, "msg", "message")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &SpamOptions{Messages: []string{}}
+
+	// Repeated msg keys rotate between messages, or pick at random with random=true
+	for _, key := range []string{"msg", "message"} {
+		for _, value := range values[key] {
+			if value != "" {
+				opts.Messages = append(opts.Messages, value)
+			}
+		}
+	}
+
+	for key, vals := range values {
+		value := vals[0]
+		switch key {
+		case "amount", "n":
+			amount, err := strconv.Atoi(value)
+			if err != nil || amount < 1 || amount > 1000 {
+				return nil, fmt.Errorf("amount must be a number between 1 and 1000")
+			}
+			opts.Amount = amount
+		case "msg", "message":
+			// Collected above
+		case "delay", "d":
+			delaySeconds, err := strconv.Atoi(value)
+			if err != nil || delaySeconds < 0 || delaySeconds > 3600 {
+				return nil, fmt.Errorf("delay must be a number between 0 and 3600 seconds")
+			}
+			opts.Delay = time.Duration(delaySeconds) * time.Second
+		case "channel", "c":
+			opts.ChannelID = strings.Trim(value, "<#>")
+		case "max", "delete", "random", "r":
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be true or false", key)
+			}
+			switch key {
+			case "max":
+				opts.UseMax = enabled
+			case "delete":
+				opts.UseDelete = enabled
+			default:
+				opts.UseRandom = enabled
+			}
+		}
+	}
+
+	// Fall back to positional amount and message
+	if opts.Amount == 0 && len(positional) > 0 {
+		amount, err := strconv.Atoi(positional[0])
+		if err != nil || amount < 1 || amount > 1000 {
+			return nil, fmt.Errorf("amount must be a number between 1 and 1000")
+		}
+		opts.Amount = amount
+		positional = positional[1:]
+	}
+	if opts.Amount == 0 {
+		return nil, fmt.Errorf("missing amount")
+	}
+
+	keyMessages := len(opts.Messages)
+	if err := c.applySpamArgs(opts, positional); err != nil {
+		return nil, err
+	}
+	if keyMessages > 0 && len(opts.Messages) > keyMessages {
+		return nil, fmt.Errorf("give the message either with msg= or as text, not both")
+	}
+
 	if len(opts.Messages) == 0 {
 		return nil, fmt.Errorf("no message content provided")
 	}
 
-	// Apply max length if requested
 	if opts.UseMax {
 		opts.Messages = c.applyMaxLength(opts.Messages)
 	}
@@ -193,13 +383,29 @@ func (c *SpamCommand) applyMaxLength(messages []string) []string {
 }
 
 // executeSpam performs the actual spamming
-func (c *SpamCommand) executeSpam(ctx context.Context, s *discordgo.Session, opts *SpamOptions) {
+func (c *SpamCommand) executeSpam(ctx context.Context, s *discordgo.Session, opts *SpamOptions, status *spamStatus) {
+	sent, reported := 0, 0
 	defer func() {
 		// Clean up when done
 		c.mu.Lock()
 		delete(c.isSpamming, opts.ChannelID)
 		delete(c.cancelFuncs, opts.ChannelID)
+		if status != nil {
+			delete(c.statusMsgs, status.messageID)
+		}
 		c.mu.Unlock()
+
+		state := "Spam completed:"
+		if ctx.Err() != nil {
+			state = "Spam stopped:"
+		}
+		c.updateStatus(s, status, c.formatStatus(opts, sent, state))
+
+		if status != nil && c.bot.GetConfig().AutoDelete.Enabled {
+			time.AfterFunc(time.Duration(c.bot.GetConfig().AutoDelete.Delay)*time.Second, func() {
+				s.ChannelMessageDelete(status.channelID, status.messageID)
+			})
+		}
 	}()
 
 	log.Infof("Starting spam: %d messages to channel %s", opts.Amount, opts.ChannelID)
@@ -212,6 +418,11 @@ func (c *SpamCommand) executeSpam(ctx context.Context, s *discordgo.Session, opt
 		default:
 		}
 
+		if sent-reported >= spamProgressInterval {
+			c.updateStatus(s, status, c.formatStatus(opts, sent, "Spamming"))
+			reported = sent
+		}
+
 		// Select message
 		var content string
 		if opts.UseRandom && len(opts.Messages) > 1 {
@@ -226,6 +437,7 @@ func (c *SpamCommand) executeSpam(ctx context.Context, s *discordgo.Session, opt
 			log.Errorf("Failed to send spam message: %v", err)
 			// Check for rate limit
 			if strings.Contains(err.Error(), "429") {
+				c.bot.GetRateLimits().RecordSource("spam")
 				log.Warn("Rate limited, waiting 5 seconds...")
 				select {
 				case <-ctx.Done():
@@ -236,6 +448,7 @@ func (c *SpamCommand) executeSpam(ctx context.Context, s *discordgo.Session, opt
 			}
 			continue
 		}
+		sent++
 
 		// Delete message if requested
 		if opts.UseDelete && msg != nil {
@@ -278,32 +491,26 @@ func (c *SpamCommand) sendUsage(s *discordgo.Session, channelID string) error {
 \` + "`" + `.spam 10 Message -max -delete\` + "`" + ` - Max length with delete
 \` + "`" + `.spam 5 -multi Hello World Test\` + "`" + ` - Rotate between messages
 \` + "`" + `.spam 3 -multi -r Hi Hey Hello\` + "`" + ` - Random multi-messages
-\` + "`" + `.spam 10 Test -d 2\` + "`" + ` - 2 second delay between messages`
+\` + "`" + `.spam 10 Test -d 2\` + "`" + ` - 2 second delay between messages
+
+**Key=value mode** (leading \` + "`" + `-kv\` + "`" + ` or \` + "`" + `key_value_args\` + "`" + ` in config):
+\` + "`" + `.spam -kv amount=10 msg="hello world" delay=2 delete=true\` + "`" + `
+\` + "`" + `.spam -kv amount=6 msg=Hi msg=Hey msg=Hello random=true\` + "`" + ` - Random multi-messages
+Keys: amount, msg, delay, channel, max, delete, random
+Repeat \` + "`" + `msg\` + "`" + ` to rotate between messages, add \` + "`" + `random=true\` + "`" + ` to pick one at random
+Other words are parsed as usual, so \` + "`" + `.spam 10 hi -delete\` + "`" + ` still works`
 
 	return c.sendTempMessage(s, channelID, usage)
 }
 
 // sendError sends an error message
 func (c *SpamCommand) sendError(s *discordgo.Session, channelID, message string) error {
-	return c.sendTempMessage(s, channelID, "❌ "+message)
+	return SendError(c.bot, s, channelID, message)
 }
 
 // sendTempMessage sends a temporary message with auto-delete
 func (c *SpamCommand) sendTempMessage(s *discordgo.Session, channelID, content string) error {
-	msg, err := s.ChannelMessageSend(channelID, content)
-	if err != nil {
-		return err
-	}
-
-	// Auto-delete after delay
-	if c.bot.GetConfig().AutoDelete.Enabled {
-		go func() {
-			time.Sleep(time.Duration(c.bot.GetConfig().AutoDelete.Delay) * time.Second)
-			s.ChannelMessageDelete(channelID, msg.ID)
-		}()
-	}
-
-	return nil
+	return SendTempMessage(c.bot, s, channelID, content)
 }
 
 // StopSpamCommand implements the stop spam command
@@ -337,8 +544,8 @@ func (c *StopSpamCommand) Execute(s *discordgo.Session, m *discordgo.MessageCrea
 		delete(c.spamCmd.isSpamming, channelID)
 		delete(c.spamCmd.cancelFuncs, channelID)
 		
-		return c.spamCmd.sendTempMessage(s, m.ChannelID, "✅ Spam stopped in target channel")
+		return SendSuccess(c.spamCmd.bot, s, m.ChannelID, "Spam stopped in target channel")
 	}
 
-	return c.spamCmd.sendTempMessage(s, m.ChannelID, "❌ No active spam found in target channel")
+	return c.spamCmd.sendError(s, m.ChannelID, "No active spam found in target channel")
 }
\ No newline at end of file
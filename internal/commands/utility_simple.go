@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -82,7 +83,7 @@ func NewSimpleInfoCommand(bot interfaces.BotInterface) *SimpleInfoCommand {
 }
 
 func (c *SimpleInfoCommand) Name() string        { return "info" }
-func (c *SimpleInfoCommand) Aliases() []string   { return []string{"about", "stats"} }
+func (c *SimpleInfoCommand) Aliases() []string   { return []string{"about", "stats", "status"} }
 func (c *SimpleInfoCommand) Description() string { return "Display bot information" }
 
 func (c *SimpleInfoCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
@@ -104,6 +105,17 @@ func (c *SimpleInfoCommand) Execute(s *discordgo.Session, m *discordgo.MessageCr
 	
 	// Format memory usage
 	memUsage := float64(memStats.Alloc) / 1024 / 1024 // Convert to MB
+
+	// This command's message is the latest event, so report the one before it
+	lastEvent := "none yet"
+	if prev := c.bot.GetPreviousEventTime(); prev.UnixNano() > 0 {
+		lastEvent = formatDuration(time.Since(prev)) + " ago"
+	}
+
+	watchdog := "disabled"
+	if cfg := c.bot.GetConfig().Watchdog; cfg.Enabled {
+		watchdog = fmt.Sprintf("enabled (%s threshold)", formatDuration(time.Duration(cfg.Threshold)*time.Second))
+	}
 	
 	content := fmt.Sprintf("```ansi\n"+
 		"\\u001b[1;35mBot Information\\n"+
@@ -113,12 +125,16 @@ func (c *SimpleInfoCommand) Execute(s *discordgo.Session, m *discordgo.MessageCr
 		"\\u001b[1;37mMemory: \\u001b[0;34m%.1f MB\\n"+
 		"\\u001b[1;37mGo Version: \\u001b[0;34m%s\\n"+
 		"\\u001b[1;37mGoroutines: \\u001b[0;34m%d\\n"+
+		"\\u001b[1;37mLast Event: \\u001b[0;34m%s\\n"+
+		"\\u001b[1;37mWatchdog: \\u001b[0;34m%s\\n"+
 		"```",
 		username, userID,
 		formatDuration(uptime),
 		memUsage,
 		runtime.Version(),
-		runtime.NumGoroutine())
+		runtime.NumGoroutine(),
+		lastEvent,
+		watchdog)
 	
 	msg, err := s.ChannelMessageSend(m.ChannelID, FormatMessage(content))
 	if err != nil {
@@ -210,13 +226,13 @@ func (c *SimpleHelpCommand) showGeneralHelp(s *discordgo.Session, channelID stri
 			seen[cmd.Name()] = true
 			
 			switch cmd.Name() {
-			case "help", "info":
+			case "help", "info", "setup":
 				categories[0].Commands = append(categories[0].Commands, cmd)
 			case "spam", "sspam":
 				categories[1].Commands = append(categories[1].Commands, cmd)
-			case "ping", "presence":
+			case "ping", "presence", "ratelimit":
 				categories[2].Commands = append(categories[2].Commands, cmd)
-			case "snipe", "editsnipe", "lastping":
+			case "snipe", "editsnipe", "lastping", "joins", "leaves":
 				categories[3].Commands = append(categories[3].Commands, cmd)
 			}
 		}
@@ -294,13 +310,14 @@ func (c *SimpleHelpCommand) showCategoryHelp(s *discordgo.Session, channelID, ca
 			var belongsToCategory bool
 			switch categoryName {
 			case "general":
-				belongsToCategory = cmd.Name() == "help" || cmd.Name() == "info"
+				belongsToCategory = cmd.Name() == "help" || cmd.Name() == "info" || cmd.Name() == "setup"
 			case "tools":
 				belongsToCategory = cmd.Name() == "spam" || cmd.Name() == "sspam"
 			case "utility":
-				belongsToCategory = cmd.Name() == "ping" || cmd.Name() == "presence"
+				belongsToCategory = cmd.Name() == "ping" || cmd.Name() == "presence" || cmd.Name() == "ratelimit"
 			case "tracking":
-				belongsToCategory = cmd.Name() == "snipe" || cmd.Name() == "editsnipe" || cmd.Name() == "lastping"
+				belongsToCategory = cmd.Name() == "snipe" || cmd.Name() == "editsnipe" || cmd.Name() == "lastping" ||
+					cmd.Name() == "joins" || cmd.Name() == "leaves"
 			}
 			
 			if belongsToCategory {
@@ -416,15 +433,19 @@ func (c *SimpleHelpCommand) showCommandHelp(s *discordgo.Session, channelID, com
 	var usage string
 	switch cmd.Name() {
 	case "spam":
-		usage = fmt.Sprintf("%sspam <amount> <message> [flags]", prefix)
+		usage = fmt.Sprintf("%sspam <amount> <message> [flags] | %sspam -kv amount=<n> msg=\"<text>\" [key=value]", prefix, prefix)
 	case "snipe":
-		usage = fmt.Sprintf("%ssnipe [user] [amount] [channel]", prefix)
+		usage = fmt.Sprintf("%ssnipe [user] [amount] [channel] | %ssnipe -kv user=<id> amount=<n> channel=<id>", prefix, prefix)
 	case "editsnipe":
 		usage = fmt.Sprintf("%seditsnipe [user] [amount] [channel]", prefix)
 	case "lastping":
 		usage = fmt.Sprintf("%slastping [amount]", prefix)
+	case "joins", "leaves":
+		usage = fmt.Sprintf("%s%s [amount] [guild_id]", prefix, cmd.Name())
 	case "presence":
 		usage = fmt.Sprintf("%spresence <status|activity|clear|show> [args]", prefix)
+	case "ratelimit":
+		usage = fmt.Sprintf("%sratelimit", prefix)
 	default:
 		usage = fmt.Sprintf("%s%s", prefix, cmd.Name())
 	}
@@ -462,18 +483,71 @@ func (c *SimpleHelpCommand) showCommandHelp(s *discordgo.Session, channelID, com
 }
 
 func (c *SimpleHelpCommand) sendError(s *discordgo.Session, channelID, message string) error {
-	msg, err := s.ChannelMessageSend(channelID, "❌ "+message)
-	if err != nil {
-		return err
+	return SendError(c.bot, s, channelID, message)
+}
+
+// SimpleRateLimitCommand shows observed rate limits (developer only)
+type SimpleRateLimitCommand struct {
+	bot interfaces.BotInterface
+}
+
+func NewSimpleRateLimitCommand(bot interfaces.BotInterface) *SimpleRateLimitCommand {
+	return &SimpleRateLimitCommand{bot: bot}
+}
+
+func (c *SimpleRateLimitCommand) Name() string        { return "ratelimit" }
+func (c *SimpleRateLimitCommand) Aliases() []string   { return []string{"rl"} }
+func (c *SimpleRateLimitCommand) Description() string { return "Show recent 429s by route and command" }
+
+func (c *SimpleRateLimitCommand) Execute(s *discordgo.Session, m *discordgo.MessageCreate, args []string) error {
+	if !c.bot.GetConfig().IsDeveloper(m.Author.ID) {
+		return SendError(c.bot, s, m.ChannelID, "This command is developer-only")
 	}
-	
-	if c.bot.GetConfig().AutoDelete.Enabled {
-		time.AfterFunc(time.Duration(c.bot.GetConfig().AutoDelete.Delay)*time.Second, func() {
-			s.ChannelMessageDelete(channelID, msg.ID)
-		})
+
+	const maxRoutes = 8
+	tracker := c.bot.GetRateLimits()
+	routes := tracker.Routes()
+
+	content := "```ansi\n" +
+		"\u001b[1;35mRate Limits\n" +
+		"\u001b[0;37m─────────────\n"
+	content += fmt.Sprintf("\u001b[1;37m429s (5m): \u001b[0;34m%d\n", tracker.RecentCount(5*time.Minute))
+	content += fmt.Sprintf("\u001b[1;37m429s (1h): \u001b[0;34m%d\n", tracker.RecentCount(time.Hour))
+
+	// 429s that surfaced to commands since startup, sorted for stable output
+	sources := tracker.Sources()
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
 	}
-	
-	return nil
+	sort.Strings(names)
+	for _, name := range names {
+		content += fmt.Sprintf("\u001b[1;37m%s (total): \u001b[0;34m%d\n", name, sources[name])
+	}
+
+	content += "\u001b[0;37m─────────────\n"
+	if len(routes) == 0 {
+		content += "\u001b[0;37mNo rate limited routes\n"
+	}
+
+	for i, route := range routes {
+		if i == maxRoutes {
+			content += fmt.Sprintf("\u001b[0;37m... and %d more\n", len(routes)-maxRoutes)
+			break
+		}
+
+		reset := "now"
+		if wait := time.Until(route.ResetAt); wait > 0 {
+			reset = fmt.Sprintf("in %.1fs", wait.Seconds())
+		}
+
+		content += fmt.Sprintf("\u001b[1;33m%s\n", TruncateContent(route.Route, 64))
+		content += fmt.Sprintf("\u001b[0;36mRetry after: %.1fs | Reset: %s | Hits: %d\n", route.RetryAfter.Seconds(), reset, route.Hits)
+	}
+
+	content += "```"
+
+	return SendTempMessage(c.bot, s, m.ChannelID, FormatMessage(content))
 }
 
 // formatDuration formats a duration into a human-readable string
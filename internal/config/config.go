@@ -2,22 +2,32 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/spf13/viper"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Tokens       []string     `mapstructure:"tokens"`
-	DeveloperIDs []string     `mapstructure:"developer_ids"`
-	CommandPrefix string      `mapstructure:"command_prefix"`
-	Version      string       `mapstructure:"version"`
-	Name         string       `mapstructure:"name"`
-	Database     Database     `mapstructure:"database"`
-	AutoDelete   AutoDelete   `mapstructure:"auto_delete"`
-	Presence     Presence     `mapstructure:"presence"`
-	NitroSniper  NitroSniper  `mapstructure:"nitro_sniper"`
+	Tokens             []string         `mapstructure:"tokens"`
+	DeveloperIDs       []string         `mapstructure:"developer_ids"`
+	CommandPrefix      string           `mapstructure:"command_prefix"`
+	Version            string           `mapstructure:"version"`
+	Name               string           `mapstructure:"name"`
+	Database           Database         `mapstructure:"database"`
+	AutoDelete         AutoDelete       `mapstructure:"auto_delete"`
+	Presence           Presence         `mapstructure:"presence"`
+	NitroSniper        NitroSniper      `mapstructure:"nitro_sniper"`
+	UI                 UI               `mapstructure:"ui"`
+	KeyValueArgs       bool             `mapstructure:"key_value_args"`
+	MemberTracking     MemberTracking   `mapstructure:"member_tracking"`
+	AttachmentMirror   AttachmentMirror `mapstructure:"attachment_mirror"`
+	StartupConcurrency int              `mapstructure:"startup_concurrency"`
+	Snipe              Snipe            `mapstructure:"snipe"`
+	Watchdog           Watchdog         `mapstructure:"watchdog"`
 }
 
 // Database configuration
@@ -84,6 +94,43 @@ type NitroStats struct {
 	RateLimited     int `mapstructure:"rate_limited"`
 }
 
+// MemberTracking configuration for guild join/leave events
+type MemberTracking struct {
+	Enabled        bool     `mapstructure:"enabled"`
+	Guilds         []string `mapstructure:"guilds"`
+	NewAccountDays int      `mapstructure:"new_account_days"`
+}
+
+// AttachmentMirror configuration for saving deleted attachments to disk
+type AttachmentMirror struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Directory string `mapstructure:"directory"`
+	MaxSizeMB int    `mapstructure:"max_size_mb"`
+}
+
+// Snipe configuration
+type Snipe struct {
+	AttachmentMode string `mapstructure:"attachment_mode"` // "interleaved" or "grouped"
+}
+
+// Watchdog configuration for detecting sessions that stopped receiving events
+type Watchdog struct {
+	Enabled   bool `mapstructure:"enabled"`
+	Threshold int  `mapstructure:"threshold"` // Seconds without events before reconnecting
+}
+
+// UI configuration for command responses
+type UI struct {
+	SuccessEmoji string `mapstructure:"success_emoji"`
+	ErrorEmoji   string `mapstructure:"error_emoji"`
+}
+
+// maxEmojiRunes limits plain-text response prefixes to a short string
+const maxEmojiRunes = 8
+
+// customEmojiPattern matches Discord custom emoji such as <:name:id>
+var customEmojiPattern = regexp.MustCompile(`^<a?:\w{2,32}:\d{17,20}>$`)
+
 // Load loads configuration from file
 func Load(filename string) (*Config, error) {
 	viper.SetConfigFile(filename)
@@ -97,6 +144,17 @@ func Load(filename string) (*Config, error) {
 	viper.SetDefault("database.name", "selfbot")
 	viper.SetDefault("auto_delete.enabled", true)
 	viper.SetDefault("auto_delete.delay", 30)
+	viper.SetDefault("startup_concurrency", 3)
+	viper.SetDefault("member_tracking.enabled", false)
+	viper.SetDefault("member_tracking.new_account_days", 7)
+	viper.SetDefault("attachment_mirror.enabled", false)
+	viper.SetDefault("attachment_mirror.directory", "mirror")
+	viper.SetDefault("attachment_mirror.max_size_mb", 25)
+	viper.SetDefault("snipe.attachment_mode", "interleaved")
+	viper.SetDefault("watchdog.enabled", false)
+	viper.SetDefault("watchdog.threshold", 900)
+	viper.SetDefault("ui.success_emoji", "✅")
+	viper.SetDefault("ui.error_emoji", "❌")
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -113,9 +171,82 @@ func Load(filename string) (*Config, error) {
 		return nil, fmt.Errorf("no tokens provided in configuration")
 	}
 
+	switch config.Snipe.AttachmentMode {
+	case "interleaved", "grouped":
+	default:
+		return nil, fmt.Errorf("snipe.attachment_mode must be interleaved or grouped")
+	}
+
+	if config.AttachmentMirror.Enabled {
+		if config.AttachmentMirror.MaxSizeMB <= 0 {
+			return nil, fmt.Errorf("attachment_mirror.max_size_mb must be greater than 0")
+		}
+		if config.AttachmentMirror.Directory == "" {
+			return nil, fmt.Errorf("attachment_mirror.directory must not be empty")
+		}
+	}
+
+	if config.Watchdog.Enabled && config.Watchdog.Threshold < 60 {
+		return nil, fmt.Errorf("watchdog.threshold must be at least 60 seconds")
+	}
+
+	if err := validateEmoji("ui.success_emoji", config.UI.SuccessEmoji); err != nil {
+		return nil, err
+	}
+	if err := validateEmoji("ui.error_emoji", config.UI.ErrorEmoji); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
+// validateEmoji ensures a response prefix is empty, a custom emoji, or a short string
+func validateEmoji(key, value string) error {
+	if value == "" || customEmojiPattern.MatchString(value) {
+		return nil
+	}
+
+	if utf8.RuneCountInString(value) > maxEmojiRunes {
+		return fmt.Errorf("%s must be a single emoji or at most %d characters", key, maxEmojiRunes)
+	}
+	if strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("%s must not contain whitespace", key)
+	}
+
+	return nil
+}
+
+// SetupValues holds the answers collected by the setup command
+type SetupValues struct {
+	CommandPrefix     string
+	DatabaseURI       string
+	AutoDeleteEnabled bool
+	AutoDeleteDelay   int
+	PresenceEnabled   bool
+	PresenceStatus    string
+}
+
+// ConfigFile returns the path of the loaded config file
+func ConfigFile() string {
+	return viper.ConfigFileUsed()
+}
+
+// WriteSetup writes setup values to filename, keeping all other loaded keys
+func WriteSetup(filename string, values SetupValues) error {
+	viper.Set("command_prefix", values.CommandPrefix)
+	viper.Set("database.uri", values.DatabaseURI)
+	viper.Set("auto_delete.enabled", values.AutoDeleteEnabled)
+	viper.Set("auto_delete.delay", values.AutoDeleteDelay)
+	viper.Set("presence.enabled", values.PresenceEnabled)
+	viper.Set("presence.status", values.PresenceStatus)
+
+	if err := viper.WriteConfigAs(filename); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
 // IsDeveloper checks if the given user ID is a developer
 func (c *Config) IsDeveloper(userID string) bool {
 	for _, devID := range c.DeveloperIDs {
@@ -126,6 +257,19 @@ func (c *Config) IsDeveloper(userID string) bool {
 	return false
 }
 
+// IsTrackedGuild checks if member events should be recorded for the given guild
+func (c *Config) IsTrackedGuild(guildID string) bool {
+	if !c.MemberTracking.Enabled {
+		return false
+	}
+	for _, id := range c.MemberTracking.Guilds {
+		if id == guildID {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRotationValues splits rotation values by periods
 func GetRotationValues(value string) []string {
 	if !strings.Contains(value, ".") {
@@ -38,18 +38,39 @@ type SimpleMessageData struct {
 }
 
 type SimpleDeletedMessageData struct {
-	MessageID   string    `bson:"message_id"`
-	UserID      string    `bson:"user_id"`
-	Username    string    `bson:"username,omitempty"`
-	Content     string    `bson:"content"`
-	DeletedAt   time.Time `bson:"deleted_at"`
-	ChannelID   string    `bson:"channel_id"`
-	ChannelName string    `bson:"channel_name,omitempty"`
-	ChannelType string    `bson:"channel_type,omitempty"`
-	IsGroup     bool      `bson:"is_group"`
-	Attachments []string  `bson:"attachments,omitempty"`
-	GuildID     string    `bson:"guild_id,omitempty"`
-	GuildName   string    `bson:"guild_name,omitempty"`
+	ID          string                 `bson:"_id"` // Message ID, so instances that see the same delete store it once
+	MessageID   string                 `bson:"message_id"`
+	UserID      string                 `bson:"user_id"`
+	Username    string                 `bson:"username,omitempty"`
+	Content     string                 `bson:"content"`
+	DeletedAt   time.Time              `bson:"deleted_at"`
+	ChannelID   string                 `bson:"channel_id"`
+	ChannelName string                 `bson:"channel_name,omitempty"`
+	ChannelType string                 `bson:"channel_type,omitempty"`
+	IsGroup     bool                   `bson:"is_group"`
+	Attachments []string               `bson:"attachments,omitempty"`
+	GuildID     string                 `bson:"guild_id,omitempty"`
+	GuildName   string                 `bson:"guild_name,omitempty"`
+	Mirrored    []SimpleAttachmentMeta `bson:"mirrored_attachments,omitempty"`
+}
+
+// SimpleAttachmentMeta describes a mirrored attachment and the blob holding its content
+type SimpleAttachmentMeta struct {
+	URL      string `bson:"url"`
+	Filename string `bson:"filename"`
+	Size     int64  `bson:"size"`
+	Hash     string `bson:"hash,omitempty"` // SHA-256 of the content, hex encoded
+	BlobID   string `bson:"blob_id,omitempty"`
+}
+
+// SimpleAttachmentBlob is a stored file shared by every attachment with the same content
+type SimpleAttachmentBlob struct {
+	ID        string    `bson:"_id"` // Content hash, suffixed with the size on a hash collision
+	Hash      string    `bson:"hash"`
+	Size      int64     `bson:"size"`
+	Path      string    `bson:"path"`
+	RefCount  int       `bson:"ref_count"`
+	CreatedAt time.Time `bson:"created_at"`
 }
 
 type SimpleEditedMessageData struct {
@@ -85,6 +106,18 @@ type SimpleMentionData struct {
 	GuildName   string    `bson:"guild_name,omitempty"`
 }
 
+type SimpleMemberEventData struct {
+	Event            string    `bson:"event"` // "join" or "leave"
+	GuildID          string    `bson:"guild_id"`
+	UserID           string    `bson:"user_id"`
+	Username         string    `bson:"username,omitempty"`
+	IsBot            bool      `bson:"is_bot"`
+	AccountCreatedAt time.Time `bson:"account_created_at"`
+	IsNewAccount     bool      `bson:"is_new_account"`
+	EventAt          time.Time `bson:"event_at"`
+	InstanceID       string    `bson:"instance_id"`
+}
+
 // NewSimpleDatabase creates a straightforward database connection
 func NewSimpleDatabase(cfg *config.Database) (*SimpleDatabase, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -126,16 +159,20 @@ func (d *SimpleDatabase) StoreMessage(msg *SimpleMessageData) error {
 	return nil
 }
 
-func (d *SimpleDatabase) StoreDeletedMessage(msg *SimpleDeletedMessageData) error {
+// StoreDeletedMessage inserts a deleted message, reporting false if another instance already stored it
+func (d *SimpleDatabase) StoreDeletedMessage(msg *SimpleDeletedMessageData) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	_, err := d.db.Collection("deleted_messages").InsertOne(ctx, msg)
-	if err != nil && !isDuplicateError(err) {
+	if isDuplicateError(err) {
+		return false, nil
+	}
+	if err != nil {
 		log.Errorf("Failed to store deleted message: %v", err)
-		return err
+		return false, err
 	}
-	return nil
+	return true, nil
 }
 
 func (d *SimpleDatabase) StoreEditedMessage(msg *SimpleEditedMessageData) error {
@@ -162,6 +199,56 @@ func (d *SimpleDatabase) StoreMention(mention *SimpleMentionData) error {
 	return nil
 }
 
+func (d *SimpleDatabase) StoreMemberEvent(event *SimpleMemberEventData) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.db.Collection("member_events").InsertOne(ctx, event)
+	if err != nil && !isDuplicateError(err) {
+		log.Errorf("Failed to store member event: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SetDeletedMessageMirror records the mirrored attachments of a stored deleted message
+func (d *SimpleDatabase) SetDeletedMessageMirror(messageID string, mirrored []SimpleAttachmentMeta) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.db.Collection("deleted_messages").UpdateOne(ctx,
+		bson.M{"_id": messageID},
+		bson.M{"$set": bson.M{"mirrored_attachments": mirrored}})
+	return err
+}
+
+// StoreAttachmentBlob inserts a new blob record, reporting false if the ID already exists
+func (d *SimpleDatabase) StoreAttachmentBlob(blob *SimpleAttachmentBlob) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.db.Collection("attachment_blobs").InsertOne(ctx, blob)
+	if isDuplicateError(err) {
+		return false, nil
+	}
+	if err != nil {
+		log.Errorf("Failed to store attachment blob: %v", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// AddAttachmentBlobRef records another attachment linked to an existing blob
+func (d *SimpleDatabase) AddAttachmentBlobRef(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.db.Collection("attachment_blobs").UpdateOne(ctx,
+		bson.M{"_id": id},
+		bson.M{"$inc": bson.M{"ref_count": 1}})
+	return err
+}
+
 // Simple query methods with proper Go idioms
 func (d *SimpleDatabase) GetDeletedMessages(filter bson.M, limit int64) ([]SimpleDeletedMessageData, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -229,6 +316,45 @@ func (d *SimpleDatabase) GetMentions(filter bson.M, limit int64) ([]SimpleMentio
 	return mentions, nil
 }
 
+func (d *SimpleDatabase) GetMemberEvents(filter bson.M, limit int64) ([]SimpleMemberEventData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{"event_at", -1}}).
+		SetLimit(limit)
+
+	cursor, err := d.db.Collection("member_events").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []SimpleMemberEventData
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetAttachmentBlob returns the blob with the given ID, or nil if none exists
+func (d *SimpleDatabase) GetAttachmentBlob(id string) (*SimpleAttachmentBlob, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var blob SimpleAttachmentBlob
+	err := d.db.Collection("attachment_blobs").FindOne(ctx, bson.M{"_id": id}).Decode(&blob)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &blob, nil
+}
+
 // Close the database connection
 func (d *SimpleDatabase) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
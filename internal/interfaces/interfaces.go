@@ -1,8 +1,11 @@
 package interfaces
 
 import (
+	"time"
+
 	"selfbot/internal/config"
 	"selfbot/internal/database"
+	"selfbot/internal/ratelimit"
 
 	"github.com/LightningDev1/discordgo"
 )
@@ -14,4 +17,7 @@ type BotInterface interface {
 	GetUserID() string
 	GetUsername() string
 	GetDatabase() *database.SimpleDatabase
+	GetRateLimits() *ratelimit.Tracker
+	GetPreviousEventTime() time.Time
+	PauseMessageLogging(channelID string) func()
 }
\ No newline at end of file
@@ -0,0 +1,172 @@
+package ratelimit
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// recentWindow is how long 429 timestamps and idle routes are retained
+const recentWindow = time.Hour
+
+// RouteState summarises the rate limits observed on a single bucket or route
+type RouteState struct {
+	Route      string // Discord bucket ID, or a normalized route when none was reported
+	Hits       int
+	RetryAfter time.Duration
+	LastHit    time.Time
+	ResetAt    time.Time
+}
+
+// Tracker records 429 responses observed by a bot instance
+type Tracker struct {
+	mu      sync.RWMutex
+	routes  map[string]*RouteState
+	sources map[string]int
+	recent  []time.Time
+}
+
+// NewTracker creates an empty rate limit tracker
+func NewTracker() *Tracker {
+	return &Tracker{
+		routes:  make(map[string]*RouteState),
+		sources: make(map[string]int),
+	}
+}
+
+// RecordRoute records a 429 reported by the REST client for a bucket or route key
+func (t *Tracker) RecordRoute(route string, retryAfter time.Duration) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, exists := t.routes[route]
+	if !exists {
+		state = &RouteState{Route: route}
+		t.routes[route] = state
+	}
+
+	state.Hits++
+	state.RetryAfter = retryAfter
+	state.LastHit = now
+	state.ResetAt = now.Add(retryAfter)
+
+	t.recent = append(t.recent, now)
+	t.pruneLocked(now)
+}
+
+// RecordSource records a 429 that surfaced to a caller such as a command
+func (t *Tracker) RecordSource(source string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sources[source]++
+}
+
+// Routes returns a copy of all route states, most recently limited first
+func (t *Tracker) Routes() []RouteState {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	routes := make([]RouteState, 0, len(t.routes))
+	for _, state := range t.routes {
+		routes = append(routes, *state)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].LastHit.After(routes[j].LastHit)
+	})
+	return routes
+}
+
+// Sources returns a copy of the per-source 429 counts
+func (t *Tracker) Sources() map[string]int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	sources := make(map[string]int, len(t.sources))
+	for source, count := range t.sources {
+		sources[source] = count
+	}
+	return sources
+}
+
+// RecentCount returns the number of route 429s seen within the window (capped at one hour)
+func (t *Tracker) RecentCount(window time.Duration) int {
+	cutoff := time.Now().Add(-window)
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	count := 0
+	for _, hit := range t.recent {
+		if hit.After(cutoff) {
+			count++
+		}
+	}
+	return count
+}
+
+// pruneLocked drops timestamps and routes idle longer than the recent window; t.mu must be held
+func (t *Tracker) pruneLocked(now time.Time) {
+	cutoff := now.Add(-recentWindow)
+	i := 0
+	for i < len(t.recent) && t.recent[i].Before(cutoff) {
+		i++
+	}
+	t.recent = t.recent[i:]
+
+	for route, state := range t.routes {
+		if state.LastHit.Before(cutoff) {
+			delete(t.routes, route)
+		}
+	}
+}
+
+// RouteKey normalizes a request URL into a route key so message-specific URLs
+// share one entry. Channel, guild and webhook IDs are kept as Discord buckets
+// rate limit per major parameter; other IDs, reaction emoji and webhook or
+// interaction tokens are replaced so the key is safe to display.
+func RouteKey(url string) string {
+	if i := strings.IndexByte(url, '?'); i >= 0 {
+		url = url[:i]
+	}
+	if i := strings.Index(url, "/api/"); i >= 0 {
+		url = url[i+len("/api/"):]
+		if j := strings.IndexByte(url, '/'); j >= 0 {
+			url = url[j:] // Drop the API version
+		}
+	}
+
+	segments := strings.Split(url, "/")
+	for i, segment := range segments {
+		if i == 0 {
+			continue
+		}
+
+		switch previous := segments[i-1]; {
+		case i >= 2 && (segments[i-2] == "webhooks" || segments[i-2] == "interactions"):
+			segments[i] = ":token"
+		case previous == "reactions":
+			segments[i] = ":emoji"
+		case isSnowflake(segment) && previous != "channels" && previous != "guilds" && previous != "webhooks":
+			segments[i] = ":id"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isSnowflake checks if a path segment looks like a Discord ID
+func isSnowflake(s string) bool {
+	if len(s) < 15 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
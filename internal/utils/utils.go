@@ -2,10 +2,14 @@ package utils
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// discordEpoch is the first millisecond of 2015, the epoch of Discord snowflakes
+const discordEpoch = 1420070400000
+
 // FormatDuration formats a time duration into a human-readable string
 func FormatDuration(start, end time.Time) string {
 	if start.IsZero() || end.IsZero() {
@@ -136,6 +140,15 @@ func IsDiscordID(s string) bool {
 	return true
 }
 
+// SnowflakeTime returns the creation time encoded in a Discord ID
+func SnowflakeTime(id string) (time.Time, error) {
+	snowflake, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli((snowflake >> 22) + discordEpoch), nil
+}
+
 // Contains checks if a slice contains a specific string
 func Contains(slice []string, item string) bool {
 	for _, s := range slice {